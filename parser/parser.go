@@ -2,27 +2,142 @@ package parser
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/ulricksennick/monkey/ast"
 	"github.com/ulricksennick/monkey/lexer"
 	"github.com/ulricksennick/monkey/token"
 )
 
+// ParseError is a single parser error along with the source position and
+// offending token it was raised for, so callers can render a precise
+// diagnostic instead of a bare message.
+type ParseError struct {
+	Message string
+	Line    int
+	Column  int
+	Token   token.Token
+}
+
+// Error implements the error interface so a ParseError can be wrapped with
+// fmt.Errorf's %w and recovered elsewhere with errors.As.
+func (e ParseError) Error() string {
+	return e.Message
+}
+
+// Operator precedence levels, lowest to highest. Used to decide how tightly
+// an infix operator binds relative to the expression around it.
+const (
+	_ int = iota
+	LOWEST
+	EQUALS      // ==
+	LESSGREATER // > or <
+	SUM         // +
+	PRODUCT     // *
+	PREFIX      // -X or !X
+	CALL        // myFunction(X)
+)
+
+// precedences maps each infix-capable token to the precedence level it binds
+// at, so the parser knows when to stop consuming an expression.
+var precedences = map[token.TokenType]int{
+	token.EQ:       EQUALS,
+	token.NOT_EQ:   EQUALS,
+	token.LT:       LESSGREATER,
+	token.GT:       LESSGREATER,
+	token.PLUS:     SUM,
+	token.MINUS:    SUM,
+	token.SLASH:    PRODUCT,
+	token.ASTERISK: PRODUCT,
+	token.LPAREN:   CALL,
+}
+
+type (
+	prefixParseFn func() ast.Expression
+	infixParseFn  func(ast.Expression) ast.Expression
+)
+
+// Mode is a bitfield of parser behavior flags
+type Mode uint8
+
+const (
+	// StopOnFirstError makes Next/ParseProgram still synchronize past the
+	// failed statement (so the token stream stays in a safe state) but
+	// return as soon as a statement fails to parse, instead of continuing
+	// to look for further statements and collect further errors.
+	StopOnFirstError Mode = 1 << iota
+	// StrictMode makes Next return a statement's ParseError (wrapped, via
+	// errors.As) instead of only recording it in errors/DetailedErrors,
+	// for callers that want to fail fast on the first bad statement
+	// without polling Errors() themselves.
+	StrictMode
+)
+
+// defaultMaxErrors bounds how many errors ParseProgram will collect before
+// bailing out, so a pathological input can't produce unbounded diagnostics.
+const defaultMaxErrors = 100
+
 // Parser implementing recursive-descent parsing
 type Parser struct {
 	l         *lexer.Lexer // lexer containing tokenized source code
 	curToken  token.Token  // current token under examination
 	peekToken token.Token  // next token; checked when forming program statements
-	errors    []string     // errors due to incorrect token types (syntax errors)
+	errors    []ParseError // errors due to incorrect token types (syntax errors)
+
+	// Mode controls optional parser behaviors; see the Mode flags above.
+	Mode Mode
+	// MaxErrors bounds how many errors ParseProgram/Next collect before
+	// giving up, defaulting to defaultMaxErrors. Zero or negative means
+	// unbounded.
+	MaxErrors int
+
+	// Trace enables parseXxx call-tree logging via trace/untrace, written
+	// to TraceOut (os.Stdout if unset). See SetTrace and parser_tracing.go.
+	Trace      bool
+	TraceOut   io.Writer
+	traceDepth int
+
+	// prefixParseFns/infixParseFns drive the Pratt parser: parseExpression
+	// looks up the fn registered for curToken's type and calls it, rather
+	// than switching on every possible expression type itself.
+	prefixParseFns map[token.TokenType]prefixParseFn
+	infixParseFns  map[token.TokenType]infixParseFn
 }
 
 // Create a new parser which will use the given lexer
 func New(l *lexer.Lexer) *Parser {
 	p := &Parser{
-		l:      l,
-		errors: []string{},
+		l:         l,
+		errors:    []ParseError{},
+		MaxErrors: defaultMaxErrors,
+		Trace:     os.Getenv("MONKEY_PARSER_TRACE") == "1",
 	}
 
+	p.prefixParseFns = make(map[token.TokenType]prefixParseFn)
+	p.registerPrefix(token.IDENT, p.parseIdentifier)
+	p.registerPrefix(token.INT, p.parseIntegerLiteral)
+	p.registerPrefix(token.BANG, p.parsePrefixExpression)
+	p.registerPrefix(token.MINUS, p.parsePrefixExpression)
+	p.registerPrefix(token.TRUE, p.parseBoolean)
+	p.registerPrefix(token.FALSE, p.parseBoolean)
+	p.registerPrefix(token.LPAREN, p.parseGroupedExpression)
+	p.registerPrefix(token.IF, p.parseIfExpression)
+	p.registerPrefix(token.FUNCTION, p.parseFunctionLiteral)
+
+	p.infixParseFns = make(map[token.TokenType]infixParseFn)
+	p.registerInfix(token.PLUS, p.parseInfixExpression)
+	p.registerInfix(token.MINUS, p.parseInfixExpression)
+	p.registerInfix(token.SLASH, p.parseInfixExpression)
+	p.registerInfix(token.ASTERISK, p.parseInfixExpression)
+	p.registerInfix(token.EQ, p.parseInfixExpression)
+	p.registerInfix(token.NOT_EQ, p.parseInfixExpression)
+	p.registerInfix(token.LT, p.parseInfixExpression)
+	p.registerInfix(token.GT, p.parseInfixExpression)
+	p.registerInfix(token.LPAREN, p.parseCallExpression)
+
 	// Read two tokens, so curToken and peekToken are both set
 	p.nextToken()
 	p.nextToken()
@@ -30,38 +145,185 @@ func New(l *lexer.Lexer) *Parser {
 	return p
 }
 
+// Register a prefix parsing function for the given token type
+func (p *Parser) registerPrefix(tokenType token.TokenType, fn prefixParseFn) {
+	p.prefixParseFns[tokenType] = fn
+}
+
+// Register an infix parsing function for the given token type
+func (p *Parser) registerInfix(tokenType token.TokenType, fn infixParseFn) {
+	p.infixParseFns[tokenType] = fn
+}
+
 // Return a program node which represents the top node of abstract syntax tree
 // generated by the parser. The AST will contain nodes representing the source
-// code provided to the parser's lexer.
+// code provided to the parser's lexer. ParseProgram is a thin loop over
+// Next, for callers that want the whole program as one in-memory AST; tools
+// that want to consume statements incrementally (a REPL, an evaluator that
+// compiles-and-discards, an LSP server) can call Next directly instead.
 func (p *Parser) ParseProgram() *ast.Program {
 	// Create a new program node
 	program := &ast.Program{}
 	// Program statements (children nodes of <program> in the AST)
 	program.Statements = []ast.Statement{}
 
-	// Iterate over tokens until end of file, parsing and appending statements
-	// to the program node's statement list
+	for {
+		stmt, err := p.Next()
+		if err != nil {
+			// io.EOF (normal end of input), the too-many-errors error once
+			// MaxErrors is reached, or, in StopOnFirstError/StrictMode, the
+			// error from a statement that failed to parse.
+			break
+		}
+		program.Statements = append(program.Statements, stmt)
+	}
+
+	// Return the program
+	return program
+}
+
+// Next parses and returns exactly one top-level statement, advancing the
+// parser past it. It returns (nil, io.EOF) once curToken reaches EOF.
+//
+// Parse errors are, by default, only recorded in errors (retrievable via
+// Errors/DetailedErrors/FormattedErrors) while Next transparently
+// synchronizes past the bad statement and keeps looking for one to return.
+// When StrictMode is set, the triggering ParseError is also wrapped and
+// returned (use errors.As to recover it) so callers that want to fail fast
+// don't have to poll Errors() themselves; Next still synchronizes first, so
+// a caller that calls Next() again afterwards keeps making forward
+// progress. Mode's StopOnFirstError behaves the same way but returns on the
+// very first error regardless of StrictMode. In every mode, once MaxErrors
+// errors have been recorded, Next returns a too-many-errors error instead of
+// looking further.
+func (p *Parser) Next() (ast.Statement, error) {
 	for !p.curTokenIs(token.EOF) {
+		errsBefore := len(p.errors)
 		stmt := p.parseStatement()
 		if stmt != nil {
-			program.Statements = append(program.Statements, stmt)
+			p.nextToken()
+			return stmt, nil
+		}
+
+		if len(p.errors) == errsBefore {
+			p.nextToken()
+			continue
+		}
+
+		// A statement failed to parse. Rather than stopping at the first
+		// bad statement (which tends to cascade into dozens of misleading
+		// follow-on errors), synchronize to the next safe point before
+		// deciding whether to surface the error or keep looking for a
+		// statement to return, mirroring how go/parser continues past
+		// syntax errors to diagnose an entire file in one pass.
+		last := p.errors[len(p.errors)-1]
+		p.synchronize()
+
+		if p.Mode&StopOnFirstError != 0 {
+			return nil, fmt.Errorf("parse error: %w", last)
+		}
+
+		if p.MaxErrors > 0 && len(p.errors) >= p.MaxErrors {
+			return nil, fmt.Errorf("too many parse errors (max %d)", p.MaxErrors)
+		}
+
+		if p.Mode&StrictMode != 0 {
+			return nil, fmt.Errorf("parse error: %w", last)
 		}
-		p.nextToken()
 	}
 
-	// Return the program
-	return program
+	return nil, io.EOF
+}
+
+// synchronize discards tokens after a failed statement until it reaches a
+// point from which parsing can safely resume: just past the next semicolon,
+// or at a token that starts a new statement (LET, RETURN, IF, FUNCTION,
+// RBRACE, or EOF). It always advances at least one token, since the failing
+// statement itself may already start with one of those keywords (e.g. "let"
+// followed by a malformed name) and curToken wouldn't otherwise move.
+func (p *Parser) synchronize() {
+	p.nextToken()
+
+	for {
+		if p.curTokenIs(token.SEMICOLON) {
+			p.nextToken()
+			return
+		}
+
+		switch p.curToken.Type {
+		case token.LET, token.RETURN, token.IF, token.FUNCTION, token.RBRACE, token.EOF:
+			return
+		}
+
+		p.nextToken()
+	}
 }
 
+// Errors returns the plain-text error messages collected so far, for
+// callers that don't need source positions.
 func (p *Parser) Errors() []string {
+	msgs := make([]string, len(p.errors))
+	for i, e := range p.errors {
+		msgs[i] = e.Message
+	}
+	return msgs
+}
+
+// DetailedErrors returns the full ParseError list, including each error's
+// source position and offending token.
+func (p *Parser) DetailedErrors() []ParseError {
 	return p.errors
 }
 
+// FormattedErrors renders each collected error against source (the original
+// program text) as a line/column-tagged message followed by the offending
+// source line with a caret pointing at the error column, similar to how
+// go/parser reports syntax errors via scanner.ErrorList.
+func (p *Parser) FormattedErrors(source string) []string {
+	lines := strings.Split(source, "\n")
+	formatted := make([]string, len(p.errors))
+
+	for i, e := range p.errors {
+		var srcLine string
+		if e.Line >= 1 && e.Line <= len(lines) {
+			srcLine = lines[e.Line-1]
+		}
+
+		col := e.Column - 1
+		if col < 0 {
+			col = 0
+		}
+		caret := strings.Repeat(" ", col) + "^"
+
+		formatted[i] = fmt.Sprintf("%d:%d: %s\n%s\n%s",
+			e.Line, e.Column, e.Message, srcLine, caret)
+	}
+
+	return formatted
+}
+
+// Add an error to the parser error list, tagged with tok's source position
+func (p *Parser) addError(msg string, tok token.Token) {
+	p.errors = append(p.errors, ParseError{
+		Message: msg,
+		Line:    tok.Line,
+		Column:  tok.Column,
+		Token:   tok,
+	})
+}
+
 // Add an error to the parser error list due to incorrect token type
 func (p *Parser) peekError(t token.TokenType) {
 	msg := fmt.Sprintf("expected next token to be %s, got %s instead",
 		t, p.peekToken.Type)
-	p.errors = append(p.errors, msg)
+	p.addError(msg, p.peekToken)
+}
+
+// Add an error to the parser error list when no prefix parse function has
+// been registered for curToken's type
+func (p *Parser) noPrefixParseFnError(t token.TokenType) {
+	msg := fmt.Sprintf("no prefix parse function for %s found", t)
+	p.addError(msg, p.curToken)
 }
 
 // Advance the parser's current and next tokens
@@ -75,10 +337,23 @@ func (p *Parser) nextToken() {
 func (p *Parser) parseStatement() ast.Statement {
 	switch p.curToken.Type {
 	case token.LET:
-		return p.parseLetStatement()
+		// Returned through a named *ast.LetStatement first, rather than
+		// directly as ast.Statement, so a nil result (parseLetStatement
+		// failed) becomes a true nil interface instead of a non-nil
+		// interface wrapping a nil pointer.
+		if stmt := p.parseLetStatement(); stmt != nil {
+			return stmt
+		}
+		return nil
 	case token.RETURN:
-		return p.parseReturnStatement()
+		if stmt := p.parseReturnStatement(); stmt != nil {
+			return stmt
+		}
+		return nil
 	default:
+		if stmt := p.parseExpressionStatement(); stmt != nil {
+			return stmt
+		}
 		return nil
 	}
 }
@@ -87,6 +362,8 @@ func (p *Parser) parseStatement() ast.Statement {
 // parser tokens while checking/asserting the next token's type for the next
 // expected token type. (let <IDENT> = <expression>)
 func (p *Parser) parseLetStatement() *ast.LetStatement {
+	defer p.untrace(p.trace("parseLetStatement"))
+
 	// Create "let" statement with current token (LET token)
 	stmt := &ast.LetStatement{Token: p.curToken}
 
@@ -101,8 +378,12 @@ func (p *Parser) parseLetStatement() *ast.LetStatement {
 		return nil
 	}
 
-	// TODO: For now, we skip over expressions until a semicolon is encountered
-	for !p.curTokenIs(token.SEMICOLON) {
+	// Advance past "=" to the start of the value expression
+	p.nextToken()
+
+	stmt.Value = p.parseExpression(LOWEST)
+
+	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
 
@@ -110,20 +391,355 @@ func (p *Parser) parseLetStatement() *ast.LetStatement {
 }
 
 func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
+	defer p.untrace(p.trace("parseReturnStatement"))
+
 	// Create "return" statement with current token (RETURN token)
 	stmt := &ast.ReturnStatement{Token: p.curToken}
 
 	// Advance the parser to beginning of expression to be parsed
 	p.nextToken()
 
-	// TODO: For now, we skip over expressions until a semicolon is encountered
-	for !p.curTokenIs(token.SEMICOLON) {
+	stmt.ReturnValue = p.parseExpression(LOWEST)
+
+	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
 
 	return stmt
 }
 
+// Construct an "expression statement" node: a bare expression used as a
+// whole statement, e.g. "x + y;" on a line by itself
+func (p *Parser) parseExpressionStatement() *ast.ExpressionStatement {
+	defer p.untrace(p.trace("parseExpressionStatement"))
+
+	stmt := &ast.ExpressionStatement{Token: p.curToken}
+
+	stmt.Expression = p.parseExpression(LOWEST)
+	if stmt.Expression == nil {
+		return nil
+	}
+
+	// The semicolon is optional so expressions can be typed at the REPL
+	// without one
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+// Parse an expression using precedence climbing: start with the prefix parser
+// registered for curToken, then keep folding in infix operators as long as
+// the next operator binds tighter than the precedence passed in
+func (p *Parser) parseExpression(precedence int) ast.Expression {
+	defer p.untrace(p.trace("parseExpression"))
+
+	prefix := p.prefixParseFns[p.curToken.Type]
+	if prefix == nil {
+		p.noPrefixParseFnError(p.curToken.Type)
+		return nil
+	}
+	leftExp := prefix()
+	if leftExp == nil {
+		// The prefix parser itself failed (e.g. a grouped or prefix
+		// expression with a bad operand); nothing left to fold an infix
+		// operator onto.
+		return nil
+	}
+
+	for !p.peekTokenIs(token.SEMICOLON) && precedence < p.peekPrecedence() {
+		infix := p.infixParseFns[p.peekToken.Type]
+		if infix == nil {
+			return leftExp
+		}
+
+		p.nextToken()
+
+		leftExp = infix(leftExp)
+		if leftExp == nil {
+			// The infix parser itself failed (e.g. a call expression with
+			// an unterminated argument list); nothing left to fold further
+			// infix operators onto.
+			return nil
+		}
+	}
+
+	return leftExp
+}
+
+func (p *Parser) parseIdentifier() ast.Expression {
+	defer p.untrace(p.trace("parseIdentifier"))
+
+	return &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+}
+
+func (p *Parser) parseIntegerLiteral() ast.Expression {
+	defer p.untrace(p.trace("parseIntegerLiteral"))
+
+	lit := &ast.IntegerLiteral{Token: p.curToken}
+
+	value, err := strconv.ParseInt(p.curToken.Literal, 0, 64)
+	if err != nil {
+		msg := fmt.Sprintf("could not parse %q as integer", p.curToken.Literal)
+		p.addError(msg, p.curToken)
+		return nil
+	}
+
+	lit.Value = value
+	return lit
+}
+
+func (p *Parser) parseBoolean() ast.Expression {
+	defer p.untrace(p.trace("parseBoolean"))
+
+	return &ast.Boolean{Token: p.curToken, Value: p.curTokenIs(token.TRUE)}
+}
+
+// Parse a prefix expression such as "-5" or "!foo": the operator has already
+// been consumed into curToken, so advance once more and parse the operand at
+// PREFIX precedence
+func (p *Parser) parsePrefixExpression() ast.Expression {
+	defer p.untrace(p.trace("parsePrefixExpression"))
+
+	expression := &ast.PrefixExpression{
+		Token:    p.curToken,
+		Operator: p.curToken.Literal,
+	}
+
+	p.nextToken()
+
+	expression.Right = p.parseExpression(PREFIX)
+	if expression.Right == nil {
+		// The operand failed to parse (e.g. "-;"); bail out with a true nil
+		// rather than handing back a PrefixExpression whose Right is nil,
+		// which ast.PrefixExpression.String() dereferences unconditionally.
+		return nil
+	}
+
+	return expression
+}
+
+// Parse an infix expression such as "5 + 5", given the already-parsed left
+// operand. Captures the operator's own precedence before descending into the
+// right operand so that same-precedence operators stay left-associative.
+func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
+	defer p.untrace(p.trace("parseInfixExpression"))
+
+	expression := &ast.InfixExpression{
+		Token:    p.curToken,
+		Operator: p.curToken.Literal,
+		Left:     left,
+	}
+
+	precedence := p.curPrecedence()
+	p.nextToken()
+	expression.Right = p.parseExpression(precedence)
+	if expression.Right == nil {
+		// Same reasoning as parsePrefixExpression: a nil Right would leave
+		// ast.InfixExpression.String() to dereference it.
+		return nil
+	}
+
+	return expression
+}
+
+// Parse a parenthesized expression: "(" <expression> ")". The parentheses
+// themselves don't produce a node, they just group precedence.
+func (p *Parser) parseGroupedExpression() ast.Expression {
+	defer p.untrace(p.trace("parseGroupedExpression"))
+
+	p.nextToken()
+
+	exp := p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	return exp
+}
+
+// Parse "if (<condition>) <consequence> else <alternative>"
+func (p *Parser) parseIfExpression() ast.Expression {
+	defer p.untrace(p.trace("parseIfExpression"))
+
+	expression := &ast.IfExpression{Token: p.curToken}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	p.nextToken()
+	expression.Condition = p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	expression.Consequence = p.parseBlockStatement()
+
+	if p.peekTokenIs(token.ELSE) {
+		p.nextToken()
+
+		if !p.expectPeek(token.LBRACE) {
+			return nil
+		}
+
+		expression.Alternative = p.parseBlockStatement()
+	}
+
+	return expression
+}
+
+// Parse a "{" <statement>* "}" block, used as if/else bodies and function
+// bodies
+func (p *Parser) parseBlockStatement() *ast.BlockStatement {
+	defer p.untrace(p.trace("parseBlockStatement"))
+
+	block := &ast.BlockStatement{Token: p.curToken}
+	block.Statements = []ast.Statement{}
+
+	p.nextToken()
+
+	for !p.curTokenIs(token.RBRACE) && !p.curTokenIs(token.EOF) {
+		errsBefore := len(p.errors)
+		stmt := p.parseStatement()
+		if stmt != nil {
+			block.Statements = append(block.Statements, stmt)
+			p.nextToken()
+			continue
+		}
+
+		if len(p.errors) == errsBefore {
+			p.nextToken()
+			continue
+		}
+
+		// A statement inside the block failed to parse. Route through the
+		// same synchronize() used by Next() at the top level, rather than
+		// stumbling forward one token at a time, so a bad statement inside
+		// a function/if body doesn't cascade into a single garbled
+		// statement for the rest of the block.
+		p.synchronize()
+
+		if p.MaxErrors > 0 && len(p.errors) >= p.MaxErrors {
+			break
+		}
+	}
+
+	return block
+}
+
+// Parse "fn" "(" <parameters> ")" <body>
+func (p *Parser) parseFunctionLiteral() ast.Expression {
+	defer p.untrace(p.trace("parseFunctionLiteral"))
+
+	lit := &ast.FunctionLiteral{Token: p.curToken}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	lit.Parameters = p.parseFunctionParameters()
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	lit.Body = p.parseBlockStatement()
+
+	return lit
+}
+
+// Parse the comma-separated identifier list in a function literal's
+// parameter list
+func (p *Parser) parseFunctionParameters() []*ast.Identifier {
+	defer p.untrace(p.trace("parseFunctionParameters"))
+
+	identifiers := []*ast.Identifier{}
+
+	if p.peekTokenIs(token.RPAREN) {
+		p.nextToken()
+		return identifiers
+	}
+
+	p.nextToken()
+
+	ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	identifiers = append(identifiers, ident)
+
+	for p.peekTokenIs(token.COMMA) {
+		p.nextToken()
+		p.nextToken()
+		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+		identifiers = append(identifiers, ident)
+	}
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	return identifiers
+}
+
+// Parse a call expression: function is the already-parsed left-hand
+// expression being called, curToken is the "(" that triggered this infix fn
+func (p *Parser) parseCallExpression(function ast.Expression) ast.Expression {
+	defer p.untrace(p.trace("parseCallExpression"))
+
+	exp := &ast.CallExpression{Token: p.curToken, Function: function}
+	exp.Arguments = p.parseCallArguments()
+	if exp.Arguments == nil {
+		// parseCallArguments returns nil (distinct from a legitimate
+		// zero-arg call's empty slice) when the argument list failed to
+		// parse, e.g. a missing closing ")". Bail out with a true nil
+		// rather than silently dropping the parsed arguments.
+		return nil
+	}
+	return exp
+}
+
+// Parse the comma-separated expression list in a call expression's
+// argument list
+func (p *Parser) parseCallArguments() []ast.Expression {
+	defer p.untrace(p.trace("parseCallArguments"))
+
+	args := []ast.Expression{}
+
+	if p.peekTokenIs(token.RPAREN) {
+		p.nextToken()
+		return args
+	}
+
+	p.nextToken()
+	arg := p.parseExpression(LOWEST)
+	if arg == nil {
+		return nil
+	}
+	args = append(args, arg)
+
+	for p.peekTokenIs(token.COMMA) {
+		p.nextToken()
+		p.nextToken()
+		arg := p.parseExpression(LOWEST)
+		if arg == nil {
+			return nil
+		}
+		args = append(args, arg)
+	}
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	return args
+}
+
 // Check whether the next token is of the expected token type, advance the parser
 func (p *Parser) expectPeek(t token.TokenType) bool {
 	if p.peekTokenIs(t) {
@@ -144,3 +760,19 @@ func (p *Parser) curTokenIs(t token.TokenType) bool {
 func (p *Parser) peekTokenIs(t token.TokenType) bool {
 	return p.peekToken.Type == t
 }
+
+// Look up the precedence of peekToken, or LOWEST if it has none registered
+func (p *Parser) peekPrecedence() int {
+	if pr, ok := precedences[p.peekToken.Type]; ok {
+		return pr
+	}
+	return LOWEST
+}
+
+// Look up the precedence of curToken, or LOWEST if it has none registered
+func (p *Parser) curPrecedence() int {
+	if pr, ok := precedences[p.curToken.Type]; ok {
+		return pr
+	}
+	return LOWEST
+}
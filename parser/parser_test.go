@@ -0,0 +1,259 @@
+package parser
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ulricksennick/monkey/ast"
+	"github.com/ulricksennick/monkey/lexer"
+)
+
+func TestOperatorPrecedenceParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"-a * b", "((-a) * b)"},
+		{"!-a", "(!(-a))"},
+		{"a + b + c", "((a + b) + c)"},
+		{"a + b - c", "((a + b) - c)"},
+		{"a * b * c", "((a * b) * c)"},
+		{"a * b / c", "((a * b) / c)"},
+		{"a + b / c", "(a + (b / c))"},
+		{"a + b * c + d / e - f", "(((a + (b * c)) + (d / e)) - f)"},
+		{"3 + 4; -5 * 5", "(3 + 4)((-5) * 5)"},
+		{"5 > 4 == 3 < 4", "((5 > 4) == (3 < 4))"},
+		{"5 < 4 != 3 > 4", "((5 < 4) != (3 > 4))"},
+		{"3 + 4 * 5 == 3 * 1 + 4 * 5", "((3 + (4 * 5)) == ((3 * 1) + (4 * 5)))"},
+		{"1 + (2 + 3) + 4", "((1 + (2 + 3)) + 4)"},
+		{"(5 + 5) * 2", "((5 + 5) * 2)"},
+		{"-(5 + 5)", "(-(5 + 5))"},
+		{"!(true == true)", "(!(true == true))"},
+		{"a + add(b * c) + d", "((a + add((b * c))) + d)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if got := program.String(); got != tt.expected {
+			t.Errorf("for input %q, expected=%q, got=%q", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestParsingErrorsDoNotPanicOnPartialAST(t *testing.T) {
+	// "foobar + ;" used to leave a *ast.InfixExpression with a nil Right in
+	// the program's statements, which ast.InfixExpression.String() then
+	// dereferenced unconditionally.
+	tests := []string{
+		"foobar + ;",
+		"-;",
+		"let x = ;",
+		"return ;",
+	}
+
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(l)
+		program := p.ParseProgram()
+
+		if len(p.Errors()) == 0 {
+			t.Errorf("input %q: expected at least one parse error", input)
+		}
+
+		// Must not panic.
+		_ = program.String()
+	}
+}
+
+func TestExpressionStatementFailureSynchronizes(t *testing.T) {
+	// The failed "foobar + ;" statement must not be returned to the caller;
+	// Next should synchronize past it and keep producing later statements.
+	input := `foobar + ;
+let x = 5;`
+
+	p := New(lexer.New(input))
+
+	stmt, err := p.Next()
+	if err != nil {
+		t.Fatalf("expected a recovered statement, got error: %v", err)
+	}
+	letStmt, ok := stmt.(*ast.LetStatement)
+	if !ok {
+		t.Fatalf("expected *ast.LetStatement, got %T", stmt)
+	}
+	if letStmt.Name.Value != "x" {
+		t.Errorf("expected let statement for 'x', got %q", letStmt.Name.Value)
+	}
+
+	if len(p.Errors()) != 1 {
+		t.Errorf("expected exactly 1 recorded error, got %d: %v", len(p.Errors()), p.Errors())
+	}
+}
+
+func TestBlockStatementFailureSynchronizes(t *testing.T) {
+	// A malformed statement inside a function body used to only advance one
+	// token at a time, cascading into a single garbled statement for the
+	// rest of the block instead of recovering at the next safe point.
+	input := `fn() {
+  let x 5;
+  return x;
+}();`
+
+	p := New(lexer.New(input))
+	program := p.ParseProgram()
+
+	if len(p.Errors()) != 1 {
+		t.Fatalf("expected exactly 1 recorded error, got %d: %v", len(p.Errors()), p.Errors())
+	}
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("expected 1 top-level statement, got %d", len(program.Statements))
+	}
+
+	exprStmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("expected *ast.ExpressionStatement, got %T", program.Statements[0])
+	}
+	call, ok := exprStmt.Expression.(*ast.CallExpression)
+	if !ok {
+		t.Fatalf("expected *ast.CallExpression, got %T", exprStmt.Expression)
+	}
+	fn, ok := call.Function.(*ast.FunctionLiteral)
+	if !ok {
+		t.Fatalf("expected *ast.FunctionLiteral, got %T", call.Function)
+	}
+
+	if len(fn.Body.Statements) != 1 {
+		t.Fatalf("expected the malformed let statement to be dropped and recovery to keep "+
+			"'return x;', got %d body statements", len(fn.Body.Statements))
+	}
+	retStmt, ok := fn.Body.Statements[0].(*ast.ReturnStatement)
+	if !ok {
+		t.Fatalf("expected *ast.ReturnStatement, got %T", fn.Body.Statements[0])
+	}
+	if retStmt.ReturnValue.String() != "x" {
+		t.Errorf("expected 'return x;' to survive recovery, got %q", retStmt.String())
+	}
+}
+
+func TestCallExpressionUnterminatedArgumentsFails(t *testing.T) {
+	// parseCallArguments returns its nil error sentinel on a missing ")";
+	// parseCallExpression must propagate that instead of returning a
+	// "successful" call with the parsed arguments silently dropped.
+	input := "foo(1, 2;"
+
+	p := New(lexer.New(input))
+	program := p.ParseProgram()
+
+	if len(p.Errors()) == 0 {
+		t.Fatal("expected at least one parse error")
+	}
+	if len(program.Statements) != 0 {
+		t.Fatalf("expected the broken call statement to be dropped, got %d statements: %s",
+			len(program.Statements), program.String())
+	}
+}
+
+func TestCallExpressionMalformedMiddleArgumentFails(t *testing.T) {
+	// A bad argument next to a comma (e.g. a stray ",") used to end up as a
+	// nil element inside an otherwise non-nil Arguments slice, which slipped
+	// past parseCallExpression's `exp.Arguments == nil` check and panicked
+	// later in ast.CallExpression.String().
+	input := "foo(1, ,);"
+
+	p := New(lexer.New(input))
+	program := p.ParseProgram()
+
+	if len(p.Errors()) == 0 {
+		t.Fatal("expected at least one parse error")
+	}
+	if len(program.Statements) != 0 {
+		t.Fatalf("expected the broken call statement to be dropped, got %d statements", len(program.Statements))
+	}
+
+	// Must not panic.
+	_ = program.String()
+}
+
+func TestNextReturnsEOF(t *testing.T) {
+	p := New(lexer.New(""))
+
+	stmt, err := p.Next()
+	if stmt != nil {
+		t.Errorf("expected nil statement at EOF, got %v", stmt)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestNextStrictModeReturnsParseError(t *testing.T) {
+	p := New(lexer.New("let x 5;"))
+	p.Mode |= StrictMode
+
+	stmt, err := p.Next()
+	if stmt != nil {
+		t.Errorf("expected nil statement, got %v", stmt)
+	}
+
+	var parseErr ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected err to wrap a ParseError, got %v", err)
+	}
+}
+
+func TestNextStopOnFirstErrorStopsAfterFirstFailure(t *testing.T) {
+	input := `let x 5;
+let y = 10;`
+
+	p := New(lexer.New(input))
+	p.Mode |= StopOnFirstError
+
+	stmt, err := p.Next()
+	if stmt != nil {
+		t.Errorf("expected nil statement, got %v", stmt)
+	}
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	// Next returned at the first failure without going on to parse
+	// "let y = 10;", so only that first error has been recorded.
+	if len(p.Errors()) != 1 {
+		t.Errorf("expected exactly 1 recorded error, got %d: %v", len(p.Errors()), p.Errors())
+	}
+}
+
+func TestFormattedErrorsIncludesSourceLineAndCaret(t *testing.T) {
+	input := "let x 5;"
+	p := New(lexer.New(input))
+	p.ParseProgram()
+
+	formatted := p.FormattedErrors(input)
+	if len(formatted) != 1 {
+		t.Fatalf("expected exactly 1 formatted error, got %d", len(formatted))
+	}
+
+	// The "=" is missing, so the error points at the "5" in column 7.
+	expected := "1:7: expected next token to be =, got INT instead\nlet x 5;\n      ^"
+	if formatted[0] != expected {
+		t.Fatalf("expected formatted error %q, got %q", expected, formatted[0])
+	}
+}
+
+func checkParserErrors(t *testing.T, p *Parser) {
+	errs := p.Errors()
+	if len(errs) == 0 {
+		return
+	}
+
+	t.Errorf("parser had %d errors", len(errs))
+	for _, msg := range errs {
+		t.Errorf("parser error: %s", msg)
+	}
+}
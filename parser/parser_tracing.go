@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// tracer records the parseXxx method a trace entry belongs to, so untrace
+// can log a matching exit line for it.
+type tracer struct {
+	msg string
+}
+
+const traceIndentUnit = "\t"
+
+// trace logs entry into a parseXxx method, along with curToken/peekToken at
+// that point, and returns a *tracer to be handed to untrace via defer:
+//
+//	defer p.untrace(p.trace("parseLetStatement"))
+//
+// It is a no-op (returning nil) unless Trace is enabled, mirroring the
+// pattern go/parser uses for its own trace/untrace helpers.
+func (p *Parser) trace(msg string) *tracer {
+	if !p.Trace {
+		return nil
+	}
+
+	p.tracePrintf("BEGIN %s (cur=%s peek=%s)", msg, p.curToken.Literal, p.peekToken.Literal)
+	p.traceDepth++
+
+	return &tracer{msg: msg}
+}
+
+// untrace logs exit from the parseXxx method that produced t. A nil t
+// (tracing disabled) is a no-op.
+func (p *Parser) untrace(t *tracer) {
+	if t == nil {
+		return
+	}
+
+	p.traceDepth--
+	p.tracePrintf("END %s", t.msg)
+}
+
+func (p *Parser) tracePrintf(format string, args ...interface{}) {
+	fmt.Fprintf(p.traceWriter(), "%s%s\n", strings.Repeat(traceIndentUnit, p.traceDepth), fmt.Sprintf(format, args...))
+}
+
+// traceWriter returns TraceOut, defaulting to os.Stdout when it hasn't been
+// set (e.g. tracing was enabled via MONKEY_PARSER_TRACE rather than SetTrace).
+func (p *Parser) traceWriter() io.Writer {
+	if p.TraceOut != nil {
+		return p.TraceOut
+	}
+	return os.Stdout
+}
+
+// SetTrace turns on tracing and directs its output to w.
+func (p *Parser) SetTrace(w io.Writer) {
+	p.Trace = true
+	p.TraceOut = w
+}
@@ -0,0 +1,77 @@
+package token
+
+// TokenType identifies the kind of lexical token (keyword, identifier,
+// operator, delimiter, etc.) produced by the lexer.
+type TokenType string
+
+// Token is a single lexical token: its type and literal text, along with
+// the line/column in the source it was read from (both 1-indexed), used to
+// give parser errors a precise location.
+type Token struct {
+	Type    TokenType
+	Literal string
+	Line    int
+	Column  int
+}
+
+const (
+	ILLEGAL = "ILLEGAL" // token/character we don't know about
+	EOF     = "EOF"     // end of file, tells the parser to stop
+
+	// Identifiers + literals
+	IDENT = "IDENT" // add, foobar, x, y, ...
+	INT   = "INT"   // 123456
+
+	// Operators
+	ASSIGN   = "="
+	PLUS     = "+"
+	MINUS    = "-"
+	BANG     = "!"
+	ASTERISK = "*"
+	SLASH    = "/"
+
+	LT = "<"
+	GT = ">"
+
+	EQ     = "=="
+	NOT_EQ = "!="
+
+	// Delimiters
+	COMMA     = ","
+	SEMICOLON = ";"
+
+	LPAREN = "("
+	RPAREN = ")"
+	LBRACE = "{"
+	RBRACE = "}"
+
+	// Keywords
+	FUNCTION = "FUNCTION"
+	LET      = "LET"
+	TRUE     = "TRUE"
+	FALSE    = "FALSE"
+	IF       = "IF"
+	ELSE     = "ELSE"
+	RETURN   = "RETURN"
+)
+
+// keywords maps the literal text of each language keyword to its token type,
+// so the lexer can distinguish keywords from plain identifiers.
+var keywords = map[string]TokenType{
+	"fn":     FUNCTION,
+	"let":    LET,
+	"true":   TRUE,
+	"false":  FALSE,
+	"if":     IF,
+	"else":   ELSE,
+	"return": RETURN,
+}
+
+// LookupIdent returns the keyword TokenType for ident if it is a reserved
+// word, otherwise it returns IDENT.
+func LookupIdent(ident string) TokenType {
+	if tok, ok := keywords[ident]; ok {
+		return tok
+	}
+	return IDENT
+}